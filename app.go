@@ -1,51 +1,72 @@
 package main
 
 import (
-	"Todo-Tasker/config"
-	"Todo-Tasker/server"
+	"context"
+	"errors"
 	"log/slog"
 	"net/http"
-)
+	"os"
+	"os/signal"
+	"syscall"
 
-type serverType struct{
-	Addr string
-	Handler *http.ServeMux
-}
+	"Todo-Tasker/config"
+	"Todo-Tasker/server"
+
+	"golang.org/x/sync/errgroup"
+)
 
 func main() {
-	_port, _adminPort := config.New()
-
-	go func(_port string) {
-		_mux := server.NewAdmin()
-		_server := serverType{
-			Addr:    _port,
-			Handler: _mux,
-		}.log()
-
-		if err := _server.ListenAndServe(); err != nil {
-			slog.Error(err.Error())
-		}
-	}(_adminPort)
-
-	_mux := server.New()
-	_server := serverType{
-		Addr:    _port,
-		Handler: _mux,
-	}.log()
-
-	if err := _server.ListenAndServe(); err != nil {
+	_cfg := config.New()
+
+	_adminServer := newServer(_cfg.AdminPort, server.NewAdmin(_cfg), _cfg)
+	_server := newServer(_cfg.Port, server.New(_cfg), _cfg)
+
+	_ctx, _stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer _stop()
+
+	_group, _groupCtx := errgroup.WithContext(_ctx)
+
+	_group.Go(func() error { return runServer(_adminServer, _cfg) })
+	_group.Go(func() error { return runServer(_server, _cfg) })
+
+	_group.Go(func() error {
+		<-_groupCtx.Done()
+
+		_shutdownCtx, _cancel := context.WithTimeout(context.Background(), _cfg.ShutdownTimeout)
+		defer _cancel()
+
+		_shutdownGroup, _ := errgroup.WithContext(_shutdownCtx)
+		_shutdownGroup.Go(func() error { return _adminServer.Shutdown(_shutdownCtx) })
+		_shutdownGroup.Go(func() error { return _server.Shutdown(_shutdownCtx) })
+		return _shutdownGroup.Wait()
+	})
+
+	if err := _group.Wait(); err != nil {
 		slog.Error(err.Error())
 	}
 }
 
-func (_server serverType) log() http.Server {
-	slog.Info(
-		"Starting server...",
-		"url",
-		"http://localhost"+_server.Addr,
-	)
-	return http.Server{
-		Addr: _server.Addr,
-		Handler: _server.Handler,
+func newServer(addr string, handler http.Handler, cfg config.Config) *http.Server {
+	return &http.Server{
+		Addr:         addr,
+		Handler:      handler,
+		ReadTimeout:  cfg.ReadTimeout,
+		WriteTimeout: cfg.WriteTimeout,
+		IdleTimeout:  cfg.IdleTimeout,
+	}
+}
+
+func runServer(srv *http.Server, cfg config.Config) error {
+	slog.Info("Starting server...", "url", "http://localhost"+srv.Addr)
+
+	var err error
+	if cfg.TLSCertFile != "" && cfg.TLSKeyFile != "" {
+		err = srv.ListenAndServeTLS(cfg.TLSCertFile, cfg.TLSKeyFile)
+	} else {
+		err = srv.ListenAndServe()
+	}
+	if errors.Is(err, http.ErrServerClosed) {
+		return nil
 	}
+	return err
 }
@@ -4,23 +4,44 @@ import (
 	"fmt"
 	"log/slog"
 	"os"
+	"time"
 )
 
-func New() (string, string) {
-	_port := os.Getenv("PORT")
-	_adminPort := os.Getenv("ADMIN_PORT")
-	if _port == "" {
-		_port = "80"
-	}
-	_port = fmt.Sprintf(
-		":%s", _port,
-	)
-	if _adminPort == "" {
-		_adminPort = "4657"
+// Config holds everything main needs to stand up both the public and
+// admin http.Server values.
+type Config struct {
+	Port      string
+	AdminPort string
+
+	TLSCertFile string
+	TLSKeyFile  string
+
+	ReadTimeout     time.Duration
+	WriteTimeout    time.Duration
+	IdleTimeout     time.Duration
+	ShutdownTimeout time.Duration
+
+	TemplatesLive bool
+}
+
+func New() Config {
+	_cfg := Config{
+		Port:      envOr("PORT", "80"),
+		AdminPort: envOr("ADMIN_PORT", "4657"),
+
+		TLSCertFile: os.Getenv("TLS_CERT_FILE"),
+		TLSKeyFile:  os.Getenv("TLS_KEY_FILE"),
+
+		ReadTimeout:     durationOr("READ_TIMEOUT", 10*time.Second),
+		WriteTimeout:    durationOr("WRITE_TIMEOUT", 10*time.Second),
+		IdleTimeout:     durationOr("IDLE_TIMEOUT", 120*time.Second),
+		ShutdownTimeout: durationOr("SHUTDOWN_TIMEOUT", 30*time.Second),
+
+		TemplatesLive: os.Getenv("TEMPLATES_MODE") == "live",
 	}
-	_adminPort = fmt.Sprintf(
-		":%s", _adminPort,
-	)
+	_cfg.Port = fmt.Sprintf(":%s", _cfg.Port)
+	_cfg.AdminPort = fmt.Sprintf(":%s", _cfg.AdminPort)
+
 	slog.SetDefault(
 		slog.New(
 			slog.NewJSONHandler(
@@ -28,5 +49,25 @@ func New() (string, string) {
 			),
 		),
 	)
-	return _port, _adminPort
+	return _cfg
+}
+
+func envOr(key, fallback string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+	return fallback
+}
+
+func durationOr(key string, fallback time.Duration) time.Duration {
+	v := os.Getenv(key)
+	if v == "" {
+		return fallback
+	}
+	_d, err := time.ParseDuration(v)
+	if err != nil {
+		slog.Error(err.Error())
+		return fallback
+	}
+	return _d
 }
@@ -0,0 +1,51 @@
+package server
+
+import (
+	"context"
+	"net/http"
+)
+
+type payloadKey struct{}
+
+type ComponentData struct {
+	Data       any
+	HXTrigger  string
+	HXRedirect string
+	HXPushURL  string
+}
+
+type DataProvider func(r *http.Request) (any, error)
+
+type ComponentRegistry map[string]DataProvider
+
+var _componentRegistry = ComponentRegistry{
+	"navbar":    navbarData,
+	"todo-list": todoListData,
+}
+
+func RegisterComponent(name string, provider DataProvider) {
+	_componentRegistry[name] = provider
+}
+
+func ComponentPayload(r *http.Request) map[string]any {
+	if v, ok := r.Context().Value(payloadKey{}).(map[string]any); ok {
+		return v
+	}
+	return map[string]any{}
+}
+
+func withComponentPayload(r *http.Request, payload map[string]any) *http.Request {
+	return r.WithContext(context.WithValue(r.Context(), payloadKey{}, payload))
+}
+
+func navbarData(r *http.Request) (any, error) {
+	return jsonApi{Login: false}, nil
+}
+
+func todoListData(r *http.Request) (any, error) {
+	_todos, err := _store.List(r.Context())
+	if err != nil {
+		return nil, err
+	}
+	return jsonApi{Todos: _todos}, nil
+}
@@ -0,0 +1,41 @@
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestRegisterComponent(t *testing.T) {
+	RegisterComponent("greeting", func(r *http.Request) (any, error) {
+		return "hello", nil
+	})
+
+	provider, ok := _componentRegistry["greeting"]
+	if !ok {
+		t.Fatal("expected greeting to be registered")
+	}
+
+	data, err := provider(httptest.NewRequest(http.MethodPost, "/c/greeting", nil))
+	if err != nil {
+		t.Fatalf("provider: %v", err)
+	}
+	if data != "hello" {
+		t.Errorf("expected %q, got %v", "hello", data)
+	}
+}
+
+func TestComponentPayloadRoundTrip(t *testing.T) {
+	req := httptest.NewRequest(http.MethodPost, "/c/greeting", nil)
+
+	if payload := ComponentPayload(req); len(payload) != 0 {
+		t.Errorf("expected empty payload, got %v", payload)
+	}
+
+	req = withComponentPayload(req, map[string]any{"name": "world"})
+
+	payload := ComponentPayload(req)
+	if payload["name"] != "world" {
+		t.Errorf("expected payload name %q, got %v", "world", payload["name"])
+	}
+}
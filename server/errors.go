@@ -0,0 +1,100 @@
+package server
+
+import (
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+)
+
+var _statusTitles = map[int]string{
+	http.StatusBadRequest:            "Bad Request",
+	http.StatusUnauthorized:          "Unauthorized",
+	http.StatusForbidden:             "Forbidden",
+	http.StatusNotFound:              "Not Found",
+	http.StatusMethodNotAllowed:      "Method Not Allowed",
+	http.StatusRequestTimeout:        "Request Timeout",
+	http.StatusConflict:              "Conflict",
+	http.StatusRequestEntityTooLarge: "Payload Too Large",
+	http.StatusUnsupportedMediaType:  "Unsupported Media Type",
+	http.StatusTooManyRequests:       "Too Many Requests",
+	http.StatusInternalServerError:   "Internal Server Error",
+	http.StatusBadGateway:            "Bad Gateway",
+	http.StatusServiceUnavailable:    "Service Unavailable",
+	http.StatusGatewayTimeout:        "Gateway Timeout",
+	http.StatusNotExtended:           "Not Extended",
+}
+
+func RenderError(w http.ResponseWriter, r *http.Request, status int, msg string) {
+	title, ok := _statusTitles[status]
+	if !ok {
+		title = http.StatusText(status)
+	}
+	if msg == "" {
+		msg = title
+	}
+
+	switch negotiate(r) {
+	case "application/json":
+		writeJSON(w, status, map[string]any{
+			"status":  status,
+			"title":   title,
+			"message": msg,
+		})
+	case "text/plain":
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		w.WriteHeader(status)
+		fmt.Fprintf(w, "%d %s: %s", status, title, msg)
+	default:
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		w.WriteHeader(status)
+		if err := _errorTempl.Execute(w, errorType{Title: title, Msg: msg}); err != nil {
+			slog.Error(err.Error())
+		}
+	}
+}
+
+func negotiate(r *http.Request) string {
+	_accept := r.Header.Get("Accept")
+	switch {
+	case strings.Contains(_accept, "application/json"):
+		return "application/json"
+	case strings.Contains(_accept, "text/html"), _accept == "", strings.Contains(_accept, "*/*"):
+		return "text/html"
+	case strings.Contains(_accept, "text/plain"):
+		return "text/plain"
+	default:
+		return "text/plain"
+	}
+}
+
+type errorInterceptor struct {
+	http.ResponseWriter
+	req       *http.Request
+	status    int
+	rewriting bool
+}
+
+func (_w *errorInterceptor) WriteHeader(status int) {
+	_w.status = status
+	if status == http.StatusMethodNotAllowed {
+		_w.rewriting = true
+		RenderError(_w.ResponseWriter, _w.req, status, "")
+		return
+	}
+	_w.ResponseWriter.WriteHeader(status)
+}
+
+func (_w *errorInterceptor) Write(b []byte) (int, error) {
+	if _w.rewriting {
+		// RenderError already wrote the body; discard ServeMux's default one.
+		return len(b), nil
+	}
+	return _w.ResponseWriter.Write(b)
+}
+
+func withErrorHandling(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		next.ServeHTTP(&errorInterceptor{ResponseWriter: w, req: r}, r)
+	})
+}
@@ -0,0 +1,29 @@
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestNegotiate(t *testing.T) {
+	cases := []struct {
+		accept string
+		want   string
+	}{
+		{"application/json", "application/json"},
+		{"text/html", "text/html"},
+		{"", "text/html"},
+		{"*/*", "text/html"},
+		{"text/plain", "text/plain"},
+		{"application/xml", "text/plain"},
+	}
+
+	for _, c := range cases {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.Header.Set("Accept", c.accept)
+		if got := negotiate(req); got != c.want {
+			t.Errorf("negotiate(Accept=%q) = %q, want %q", c.accept, got, c.want)
+		}
+	}
+}
@@ -0,0 +1,113 @@
+package server
+
+import (
+	"compress/gzip"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+)
+
+type Middleware func(http.Handler) http.Handler
+
+// mws[0] runs outermost.
+func chain(h http.Handler, mws ...Middleware) http.Handler {
+	for i := len(mws) - 1; i >= 0; i-- {
+		h = mws[i](h)
+	}
+	return h
+}
+
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+	bytes  int
+}
+
+func (_rec *statusRecorder) WriteHeader(status int) {
+	_rec.status = status
+	_rec.ResponseWriter.WriteHeader(status)
+}
+
+func (_rec *statusRecorder) Write(b []byte) (int, error) {
+	n, err := _rec.ResponseWriter.Write(b)
+	_rec.bytes += n
+	return n, err
+}
+
+func requestLogger(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_start := time.Now()
+		_rec := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+
+		next.ServeHTTP(_rec, r)
+
+		slog.Info(
+			"request",
+			"method", r.Method,
+			"path", r.URL.Path,
+			"status", _rec.status,
+			"duration", time.Since(_start).String(),
+			"bytes", _rec.bytes,
+		)
+	})
+}
+
+func recoverer(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		defer func() {
+			if _err := recover(); _err != nil {
+				slog.Error(fmt.Sprint(_err))
+				RenderError(w, r, http.StatusInternalServerError, "")
+			}
+		}()
+		next.ServeHTTP(w, r)
+	})
+}
+
+var _gzipSkipTypes = map[string]bool{
+	"wasm": true,
+}
+
+type gzipResponseWriter struct {
+	http.ResponseWriter
+	writer *gzip.Writer
+}
+
+func (_w *gzipResponseWriter) Write(b []byte) (int, error) {
+	return _w.writer.Write(b)
+}
+
+func gzipCompress(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.Contains(r.Header.Get("Accept-Encoding"), "gzip") {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		// A Range request addresses byte offsets in the uncompressed file;
+		// http.ServeContent sets Content-Range for those bytes regardless of
+		// Content-Encoding, and compressing just the requested slice produces
+		// a truncated gzip stream a client can't decode. Serve it plain.
+		if r.Header.Get("Range") != "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		if _parts := strings.Split(r.URL.Path, "."); len(_parts) > 1 {
+			if _gzipSkipTypes[_parts[len(_parts)-1]] {
+				next.ServeHTTP(w, r)
+				return
+			}
+		}
+
+		w.Header().Set("Content-Encoding", "gzip")
+		w.Header().Add("Vary", "Accept-Encoding")
+
+		_gz := gzip.NewWriter(w)
+		defer _gz.Close()
+
+		next.ServeHTTP(&gzipResponseWriter{ResponseWriter: w, writer: _gz}, r)
+	})
+}
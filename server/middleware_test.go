@@ -0,0 +1,66 @@
+package server
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"Todo-Tasker/config"
+)
+
+func TestRecovererSurvivesGzipCompression(t *testing.T) {
+	initTemplates(config.Config{})
+
+	_handler := chain(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		panic("boom")
+	}), gzipCompress, recoverer)
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set("Accept-Encoding", "gzip")
+	w := httptest.NewRecorder()
+
+	_handler.ServeHTTP(w, req)
+
+	if w.Code != http.StatusInternalServerError {
+		t.Fatalf("expected status %d, got %d", http.StatusInternalServerError, w.Code)
+	}
+
+	_gz, err := gzip.NewReader(w.Body)
+	if err != nil {
+		t.Fatalf("response body is not valid gzip: %v", err)
+	}
+	defer _gz.Close()
+
+	if _, err := io.ReadAll(_gz); err != nil {
+		t.Fatalf("failed to read gzip body: %v", err)
+	}
+}
+
+func TestGzipCompressSkipsRangeRequests(t *testing.T) {
+	_body := []byte("hello world")
+
+	_handler := chain(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.ServeContent(w, r, "test.txt", time.Time{}, bytes.NewReader(_body))
+	}), gzipCompress)
+
+	req := httptest.NewRequest(http.MethodGet, "/assets/test.txt", nil)
+	req.Header.Set("Accept-Encoding", "gzip")
+	req.Header.Set("Range", "bytes=0-4")
+	w := httptest.NewRecorder()
+
+	_handler.ServeHTTP(w, req)
+
+	if w.Code != http.StatusPartialContent {
+		t.Fatalf("expected status %d, got %d", http.StatusPartialContent, w.Code)
+	}
+	if w.Header().Get("Content-Encoding") == "gzip" {
+		t.Fatal("expected Range response not to be gzip-compressed")
+	}
+	if got := w.Body.String(); got != "hello" {
+		t.Errorf("expected body %q, got %q", "hello", got)
+	}
+}
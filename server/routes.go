@@ -1,7 +1,9 @@
 package server
 
 import (
+	"encoding/json"
 	"fmt"
+	"io"
 	"log/slog"
 	"net/http"
 	"strings"
@@ -32,48 +34,82 @@ func assetsWithType(w http.ResponseWriter, r *http.Request) {
 func componentsPage(w http.ResponseWriter, r *http.Request) {
 	name := r.PathValue("name")
 
-	if err := _componentsTempl.ExecuteTemplate(w, name, nil); err != nil {
+	provider, ok := _componentRegistry[name]
+	if !ok {
+		RenderError(w, r, http.StatusNotFound, "Component not found!")
+		return
+	}
+
+	payload, err := parseComponentPayload(r)
+	if err != nil {
+		RenderError(w, r, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+	r = withComponentPayload(r, payload)
+
+	data, err := provider(r)
+	if err != nil {
 		slog.Error(err.Error())
-		http.Redirect(w, r, "/404", http.StatusFound)
+		RenderError(w, r, http.StatusInternalServerError, "")
+		return
+	}
+
+	_templateData := data
+	if _cd, ok := data.(ComponentData); ok {
+		if _cd.HXTrigger != "" {
+			w.Header().Set("HX-Trigger", _cd.HXTrigger)
+		}
+		if _cd.HXRedirect != "" {
+			w.Header().Set("HX-Redirect", _cd.HXRedirect)
+		}
+		if _cd.HXPushURL != "" {
+			w.Header().Set("HX-Push-Url", _cd.HXPushURL)
+		}
+		_templateData = _cd.Data
+	}
+
+	if err := _componentsTempl.ExecuteTemplate(w, name, _templateData); err != nil {
+		RenderError(w, r, http.StatusInternalServerError, "")
 	}
 }
 
-func indexPage(w http.ResponseWriter, r *http.Request) {
-	if r.URL.Path != "/" {
-		http.Redirect(w, r, "/404", http.StatusFound)
+// parseComponentPayload reads the POST body as JSON when Content-Type
+// says so, falling back to a parsed form body otherwise.
+func parseComponentPayload(r *http.Request) (map[string]any, error) {
+	if strings.Contains(r.Header.Get("Content-Type"), "application/json") {
+		_payload := map[string]any{}
+		if err := json.NewDecoder(r.Body).Decode(&_payload); err != nil && err != io.EOF {
+			return nil, err
+		}
+		return _payload, nil
 	}
+
+	if err := r.ParseForm(); err != nil {
+		return nil, err
+	}
+	_payload := map[string]any{}
+	for key := range r.PostForm {
+		_payload[key] = r.PostForm.Get(key)
+	}
+	return _payload, nil
+}
+
+func indexPage(w http.ResponseWriter, r *http.Request) {
 	if err := _indexTempl.Execute(w, nil); err != nil {
-		slog.Error(err.Error())
-		http.Redirect(w, r, "/404", http.StatusFound)
+		RenderError(w, r, http.StatusInternalServerError, "")
 	}
 }
 
 func adminPage(w http.ResponseWriter, r *http.Request) {
 	if err := _adminTempl.Execute(w, nil); err != nil {
-		slog.Error(err.Error())
-		http.Redirect(w, r, "/404", http.StatusFound)
+		RenderError(w, r, http.StatusInternalServerError, "")
 	}
 }
 
-// need 400 to 510 error handling
-
 func pageNotFound(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotFound)
-	if err := _errorTempl.Execute(w, errorType{
-		Title: "404",
-		Msg:   "Page Not found!",
-	}); err != nil {
-		slog.Error(err.Error())
-		http.Redirect(w, r, "/error", http.StatusFound)
-	}
+	RenderError(w, r, http.StatusNotFound, "Page Not found!")
 }
 
 func errorPage(w http.ResponseWriter, r *http.Request) {
-	if err := _errorTempl.Execute(w, errorType{
-		Title: "500",
-		Msg:   "Oops! somthing went Wrong!",
-	}); err != nil {
-		http.Error(w, "Internal error!", http.StatusInternalServerError)
-		slog.Error(err.Error())
-	}
+	RenderError(w, r, http.StatusInternalServerError, "Oops! somthing went Wrong!")
 }
@@ -6,29 +6,29 @@ import (
 	"log/slog"
 	"maps"
 	"net/http"
+	"os"
+	"sync"
+
+	"Todo-Tasker/config"
+	"Todo-Tasker/store"
 )
 
 type (
 	routes map[string]http.HandlerFunc
 
+	chainedRoutes struct {
+		routes      routes
+		middlewares []Middleware
+	}
+
 	errorType struct {
 		Title string
 		Msg   string
 	}
 
 	jsonApi struct {
-		Login bool   `json:"login"`
-		Todos []todo `json:"todos"`
-	}
-
-	todo struct {
-		Title       string `json:"title"`
-		Url         string `json:"url"`
-		Img         string `json:"img"`
-		Alt         string `json:"alt"`
-		Description string `json:"description"`
-		UserName    string `json:"user_name"`
-		CreatedAt   string `json:"created_at"`
+		Login bool         `json:"login"`
+		Todos []store.Todo `json:"todos"`
 	}
 )
 
@@ -44,22 +44,51 @@ var (
 
 	_components = "components/*.html"
 
-	_indexTempl *template.Template
-	_adminTempl *template.Template
-	_errorTempl *template.Template
+	_templatesLive bool
+	_templatesOnce sync.Once
+
+	_indexTempl templateExecutor
+	_adminTempl templateExecutor
+	_errorTempl templateExecutor
 
-	_componentsTempl *template.Template
+	_componentsTempl templateExecutor
+
+	_store     store.Store
+	_storeOnce sync.Once
 )
 
-func init() {
-	_indexTempl = getTemplate("index.html")
-	_adminTempl = getTemplate("admin.html")
-	_errorTempl = getTemplate("error.html")
+func initStore() {
+	_storeOnce.Do(func() {
+		_store = getStore()
+	})
+}
+
+func initTemplates(cfg config.Config) {
+	_templatesOnce.Do(func() {
+		_templatesLive = cfg.TemplatesLive
 
-	_componentsTempl = getTemplate(_components)
+		_indexTempl = getTemplate("index.html")
+		_adminTempl = getTemplate("admin.html")
+		_errorTempl = getTemplate("error.html")
+
+		_componentsTempl = getTemplate(_components)
+	})
 }
 
-func getTemplate(filename string) *template.Template {
+func getStore() store.Store {
+	_s, err := store.New()
+	if err != nil {
+		slog.Error(err.Error())
+		os.Exit(1)
+	}
+	return _s
+}
+
+func getTemplate(filename string) templateExecutor {
+	if _templatesLive {
+		return newTemplateSet("layout.html", filename)
+	}
+
 	temp, err := template.ParseFS(_pages, _layout, _dir+filename)
 	if err != nil {
 		slog.Error(err.Error())
@@ -86,14 +115,30 @@ func (_routes routes) createRoutes() *http.ServeMux {
 	return _mux
 }
 
-func New() *http.ServeMux {
+func (_routes routes) Use(mws ...Middleware) chainedRoutes {
+	return chainedRoutes{routes: _routes, middlewares: mws}
+}
+
+func (_chained chainedRoutes) createRoutes() http.Handler {
+	return chain(_chained.routes.createRoutes(), _chained.middlewares...)
+}
+
+func New(cfg config.Config) http.Handler {
+	initTemplates(cfg)
+	initStore()
 	return routes{
-		"/": indexPage,
-	}.getCommonRoutes().createRoutes()
+		"GET /{$}":               indexPage,
+		"GET /api/todos":         todosIndex,
+		"POST /api/todos":        todosCreate,
+		"PUT /api/todos/{id}":    todosUpdate,
+		"DELETE /api/todos/{id}": todosDelete,
+	}.getCommonRoutes().Use(requestLogger, gzipCompress, recoverer, withErrorHandling).createRoutes()
 }
 
-func NewAdmin() *http.ServeMux {
+func NewAdmin(cfg config.Config) http.Handler {
+	initTemplates(cfg)
+	initStore()
 	return routes{
-		"/": adminPage,
-	}.getCommonRoutes().createRoutes()
+		"GET /{$}": adminPage,
+	}.getCommonRoutes().Use(requestLogger, gzipCompress, recoverer, withErrorHandling).createRoutes()
 }
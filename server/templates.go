@@ -0,0 +1,104 @@
+package server
+
+import (
+	"html/template"
+	"io"
+	"io/fs"
+	"os"
+	"sync"
+	"time"
+)
+
+type templateExecutor interface {
+	Execute(w io.Writer, data any) error
+	ExecuteTemplate(w io.Writer, name string, data any) error
+}
+
+// TemplateSet re-parses from os.DirFS("pages") whenever a source file's
+// mtime moves forward. Safe for concurrent use.
+type TemplateSet struct {
+	mu sync.RWMutex
+
+	fsys    fs.FS
+	layout  string
+	pattern string
+
+	mtime time.Time
+	tmpl  *template.Template
+}
+
+func newTemplateSet(layout, pattern string) *TemplateSet {
+	return &TemplateSet{
+		fsys:    os.DirFS(_dir),
+		layout:  layout,
+		pattern: pattern,
+	}
+}
+
+func (_ts *TemplateSet) Execute(w io.Writer, data any) error {
+	if err := _ts.refreshIfStale(); err != nil {
+		return err
+	}
+	_ts.mu.RLock()
+	defer _ts.mu.RUnlock()
+	return _ts.tmpl.Execute(w, data)
+}
+
+func (_ts *TemplateSet) ExecuteTemplate(w io.Writer, name string, data any) error {
+	if err := _ts.refreshIfStale(); err != nil {
+		return err
+	}
+	_ts.mu.RLock()
+	defer _ts.mu.RUnlock()
+	return _ts.tmpl.ExecuteTemplate(w, name, data)
+}
+
+func (_ts *TemplateSet) refreshIfStale() error {
+	_ts.mu.RLock()
+	_parsed := _ts.tmpl != nil
+	_cachedMtime := _ts.mtime
+	_ts.mu.RUnlock()
+
+	_latest, err := _ts.latestMtime()
+	if err != nil {
+		return err
+	}
+	if _parsed && !_latest.After(_cachedMtime) {
+		return nil
+	}
+
+	_ts.mu.Lock()
+	defer _ts.mu.Unlock()
+
+	if _ts.tmpl != nil && !_latest.After(_ts.mtime) {
+		return nil
+	}
+
+	_tmpl, err := template.ParseFS(_ts.fsys, _ts.layout, _ts.pattern)
+	if err != nil {
+		return err
+	}
+	_ts.tmpl = _tmpl
+	_ts.mtime = _latest
+	return nil
+}
+
+func (_ts *TemplateSet) latestMtime() (time.Time, error) {
+	_files, err := fs.Glob(_ts.fsys, _ts.pattern)
+	if err != nil {
+		return time.Time{}, err
+	}
+	_files = append(_files, _ts.layout)
+
+	var _latest time.Time
+	for _, f := range _files {
+		_info, err := fs.Stat(_ts.fsys, f)
+		if err != nil {
+			return time.Time{}, err
+		}
+		if _info.ModTime().After(_latest) {
+			_latest = _info.ModTime()
+		}
+	}
+	return _latest, nil
+}
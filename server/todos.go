@@ -0,0 +1,89 @@
+package server
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"Todo-Tasker/store"
+)
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		slog.Error(err.Error())
+	}
+}
+
+func todosIndex(w http.ResponseWriter, r *http.Request) {
+	_todos, err := _store.List(r.Context())
+	if err != nil {
+		slog.Error(err.Error())
+		RenderError(w, r, http.StatusInternalServerError, "failed to list todos")
+		return
+	}
+	writeJSON(w, http.StatusOK, _todos)
+}
+
+func todosCreate(w http.ResponseWriter, r *http.Request) {
+	var t store.Todo
+	if err := json.NewDecoder(r.Body).Decode(&t); err != nil {
+		RenderError(w, r, http.StatusBadRequest, "invalid request body")
+		return
+	}
+	if t.Title == "" {
+		RenderError(w, r, http.StatusBadRequest, "title is required")
+		return
+	}
+
+	_created, err := _store.Create(r.Context(), t)
+	if err != nil {
+		slog.Error(err.Error())
+		RenderError(w, r, http.StatusInternalServerError, "failed to create todo")
+		return
+	}
+	writeJSON(w, http.StatusCreated, _created)
+}
+
+func todosUpdate(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+
+	var t store.Todo
+	if err := json.NewDecoder(r.Body).Decode(&t); err != nil {
+		RenderError(w, r, http.StatusBadRequest, "invalid request body")
+		return
+	}
+	if t.Title == "" {
+		RenderError(w, r, http.StatusBadRequest, "title is required")
+		return
+	}
+
+	_updated, err := _store.Update(r.Context(), id, t)
+	if err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			RenderError(w, r, http.StatusNotFound, "todo not found")
+			return
+		}
+		slog.Error(err.Error())
+		RenderError(w, r, http.StatusInternalServerError, "failed to update todo")
+		return
+	}
+	writeJSON(w, http.StatusOK, _updated)
+}
+
+func todosDelete(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+
+	if err := _store.Delete(r.Context(), id); err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			RenderError(w, r, http.StatusNotFound, "todo not found")
+			return
+		}
+		slog.Error(err.Error())
+		RenderError(w, r, http.StatusInternalServerError, "failed to delete todo")
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,99 @@
+package store
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+
+	"go.etcd.io/bbolt"
+)
+
+var _todosBucket = []byte("todos")
+
+type boltStore struct {
+	db *bbolt.DB
+}
+
+func NewBolt(path string) (Store, error) {
+	db, err := bbolt.Open(path, 0600, nil)
+	if err != nil {
+		return nil, err
+	}
+	if err := db.Update(func(tx *bbolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(_todosBucket)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	return &boltStore{db: db}, nil
+}
+
+func (s *boltStore) List(ctx context.Context) ([]Todo, error) {
+	var _todos []Todo
+	err := s.db.View(func(tx *bbolt.Tx) error {
+		return tx.Bucket(_todosBucket).ForEach(func(k, v []byte) error {
+			var t Todo
+			if err := json.Unmarshal(v, &t); err != nil {
+				return err
+			}
+			_todos = append(_todos, t)
+			return nil
+		})
+	})
+	return _todos, err
+}
+
+func (s *boltStore) Get(ctx context.Context, id string) (Todo, error) {
+	var t Todo
+	err := s.db.View(func(tx *bbolt.Tx) error {
+		v := tx.Bucket(_todosBucket).Get([]byte(id))
+		if v == nil {
+			return ErrNotFound
+		}
+		return json.Unmarshal(v, &t)
+	})
+	return t, err
+}
+
+func (s *boltStore) Create(ctx context.Context, t Todo) (Todo, error) {
+	err := s.db.Update(func(tx *bbolt.Tx) error {
+		_bucket := tx.Bucket(_todosBucket)
+		id, err := _bucket.NextSequence()
+		if err != nil {
+			return err
+		}
+		t.ID = strconv.FormatUint(id, 10)
+		v, err := json.Marshal(t)
+		if err != nil {
+			return err
+		}
+		return _bucket.Put([]byte(t.ID), v)
+	})
+	return t, err
+}
+
+func (s *boltStore) Update(ctx context.Context, id string, t Todo) (Todo, error) {
+	t.ID = id
+	err := s.db.Update(func(tx *bbolt.Tx) error {
+		_bucket := tx.Bucket(_todosBucket)
+		if _bucket.Get([]byte(id)) == nil {
+			return ErrNotFound
+		}
+		v, err := json.Marshal(t)
+		if err != nil {
+			return err
+		}
+		return _bucket.Put([]byte(id), v)
+	})
+	return t, err
+}
+
+func (s *boltStore) Delete(ctx context.Context, id string) error {
+	return s.db.Update(func(tx *bbolt.Tx) error {
+		_bucket := tx.Bucket(_todosBucket)
+		if _bucket.Get([]byte(id)) == nil {
+			return ErrNotFound
+		}
+		return _bucket.Delete([]byte(id))
+	})
+}
@@ -0,0 +1,72 @@
+package store
+
+import (
+	"context"
+	"strconv"
+	"sync"
+)
+
+type memoryStore struct {
+	mu     sync.RWMutex
+	todos  map[string]Todo
+	nextID int
+}
+
+func NewMemory() Store {
+	return &memoryStore{todos: make(map[string]Todo)}
+}
+
+func (s *memoryStore) List(ctx context.Context) ([]Todo, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	_todos := make([]Todo, 0, len(s.todos))
+	for _, t := range s.todos {
+		_todos = append(_todos, t)
+	}
+	return _todos, nil
+}
+
+func (s *memoryStore) Get(ctx context.Context, id string) (Todo, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	t, ok := s.todos[id]
+	if !ok {
+		return Todo{}, ErrNotFound
+	}
+	return t, nil
+}
+
+func (s *memoryStore) Create(ctx context.Context, t Todo) (Todo, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.nextID++
+	t.ID = strconv.Itoa(s.nextID)
+	s.todos[t.ID] = t
+	return t, nil
+}
+
+func (s *memoryStore) Update(ctx context.Context, id string, t Todo) (Todo, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.todos[id]; !ok {
+		return Todo{}, ErrNotFound
+	}
+	t.ID = id
+	s.todos[id] = t
+	return t, nil
+}
+
+func (s *memoryStore) Delete(ctx context.Context, id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.todos[id]; !ok {
+		return ErrNotFound
+	}
+	delete(s.todos, id)
+	return nil
+}
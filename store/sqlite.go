@@ -0,0 +1,115 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"strconv"
+
+	_ "modernc.org/sqlite"
+)
+
+const _createTodosTable = `
+CREATE TABLE IF NOT EXISTS todos (
+	id           INTEGER PRIMARY KEY AUTOINCREMENT,
+	title        TEXT NOT NULL,
+	url          TEXT,
+	img          TEXT,
+	alt          TEXT,
+	description  TEXT,
+	user_name    TEXT,
+	created_at   TEXT
+)`
+
+type sqliteStore struct {
+	db *sql.DB
+}
+
+func NewSQLite(path string) (Store, error) {
+	db, err := sql.Open("sqlite", path)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := db.Exec(_createTodosTable); err != nil {
+		return nil, err
+	}
+	return &sqliteStore{db: db}, nil
+}
+
+func (s *sqliteStore) List(ctx context.Context) ([]Todo, error) {
+	rows, err := s.db.QueryContext(ctx, `SELECT id, title, url, img, alt, description, user_name, created_at FROM todos`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var _todos []Todo
+	for rows.Next() {
+		var t Todo
+		if err := rows.Scan(&t.ID, &t.Title, &t.Url, &t.Img, &t.Alt, &t.Description, &t.UserName, &t.CreatedAt); err != nil {
+			return nil, err
+		}
+		_todos = append(_todos, t)
+	}
+	return _todos, rows.Err()
+}
+
+func (s *sqliteStore) Get(ctx context.Context, id string) (Todo, error) {
+	var t Todo
+	row := s.db.QueryRowContext(ctx, `SELECT id, title, url, img, alt, description, user_name, created_at FROM todos WHERE id = ?`, id)
+	if err := row.Scan(&t.ID, &t.Title, &t.Url, &t.Img, &t.Alt, &t.Description, &t.UserName, &t.CreatedAt); err != nil {
+		if err == sql.ErrNoRows {
+			return Todo{}, ErrNotFound
+		}
+		return Todo{}, err
+	}
+	return t, nil
+}
+
+func (s *sqliteStore) Create(ctx context.Context, t Todo) (Todo, error) {
+	_result, err := s.db.ExecContext(
+		ctx,
+		`INSERT INTO todos (title, url, img, alt, description, user_name, created_at) VALUES (?, ?, ?, ?, ?, ?, ?)`,
+		t.Title, t.Url, t.Img, t.Alt, t.Description, t.UserName, t.CreatedAt,
+	)
+	if err != nil {
+		return Todo{}, err
+	}
+	id, err := _result.LastInsertId()
+	if err != nil {
+		return Todo{}, err
+	}
+	return s.Get(ctx, strconv.FormatInt(id, 10))
+}
+
+func (s *sqliteStore) Update(ctx context.Context, id string, t Todo) (Todo, error) {
+	_result, err := s.db.ExecContext(
+		ctx,
+		`UPDATE todos SET title = ?, url = ?, img = ?, alt = ?, description = ?, user_name = ?, created_at = ? WHERE id = ?`,
+		t.Title, t.Url, t.Img, t.Alt, t.Description, t.UserName, t.CreatedAt, id,
+	)
+	if err != nil {
+		return Todo{}, err
+	}
+	if n, err := _result.RowsAffected(); err != nil {
+		return Todo{}, err
+	} else if n == 0 {
+		return Todo{}, ErrNotFound
+	}
+	t.ID = id
+	return t, nil
+}
+
+func (s *sqliteStore) Delete(ctx context.Context, id string) error {
+	_result, err := s.db.ExecContext(ctx, `DELETE FROM todos WHERE id = ?`, id)
+	if err != nil {
+		return err
+	}
+	n, err := _result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
@@ -0,0 +1,50 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"os"
+)
+
+var ErrNotFound = errors.New("store: todo not found")
+
+type Todo struct {
+	ID          string `json:"id"`
+	Title       string `json:"title"`
+	Url         string `json:"url"`
+	Img         string `json:"img"`
+	Alt         string `json:"alt"`
+	Description string `json:"description"`
+	UserName    string `json:"user_name"`
+	CreatedAt   string `json:"created_at"`
+}
+
+type Store interface {
+	List(ctx context.Context) ([]Todo, error)
+	Get(ctx context.Context, id string) (Todo, error)
+	Create(ctx context.Context, t Todo) (Todo, error)
+	Update(ctx context.Context, id string, t Todo) (Todo, error)
+	Delete(ctx context.Context, id string) error
+}
+
+// New builds a Store from STORE_BACKEND/STORE_PATH, defaulting to memory.
+func New() (Store, error) {
+	backend := envOr("STORE_BACKEND", "memory")
+	switch backend {
+	case "bolt":
+		return NewBolt(envOr("STORE_PATH", "todos.db"))
+	case "sqlite":
+		return NewSQLite(envOr("STORE_PATH", "todos.sqlite"))
+	case "memory":
+		return NewMemory(), nil
+	default:
+		return nil, errors.New("store: unknown STORE_BACKEND " + backend)
+	}
+}
+
+func envOr(key, fallback string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+	return fallback
+}
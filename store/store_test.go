@@ -0,0 +1,75 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestMemoryStoreCRUD(t *testing.T) {
+	s := NewMemory()
+	ctx := context.Background()
+
+	_created, err := s.Create(ctx, Todo{Title: "write tests"})
+	if err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	if _created.ID == "" {
+		t.Fatal("expected Create to assign an ID")
+	}
+
+	_got, err := s.Get(ctx, _created.ID)
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	if _got.Title != "write tests" {
+		t.Errorf("expected title %q, got %q", "write tests", _got.Title)
+	}
+
+	_updated, err := s.Update(ctx, _created.ID, Todo{Title: "write more tests"})
+	if err != nil {
+		t.Fatalf("Update: %v", err)
+	}
+	if _updated.Title != "write more tests" {
+		t.Errorf("expected updated title %q, got %q", "write more tests", _updated.Title)
+	}
+
+	_list, err := s.List(ctx)
+	if err != nil {
+		t.Fatalf("List: %v", err)
+	}
+	if len(_list) != 1 {
+		t.Fatalf("expected 1 todo, got %d", len(_list))
+	}
+
+	if err := s.Delete(ctx, _created.ID); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+
+	if _, err := s.Get(ctx, _created.ID); !errors.Is(err, ErrNotFound) {
+		t.Errorf("expected ErrNotFound after delete, got %v", err)
+	}
+}
+
+func TestMemoryStoreNotFound(t *testing.T) {
+	s := NewMemory()
+	ctx := context.Background()
+
+	if _, err := s.Get(ctx, "missing"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Get: expected ErrNotFound, got %v", err)
+	}
+	if _, err := s.Update(ctx, "missing", Todo{Title: "x"}); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Update: expected ErrNotFound, got %v", err)
+	}
+	if err := s.Delete(ctx, "missing"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Delete: expected ErrNotFound, got %v", err)
+	}
+}
+
+func TestNewUnknownBackend(t *testing.T) {
+	t.Setenv("STORE_BACKEND", "unknown")
+
+	if _, err := New(); err == nil {
+		t.Fatal("expected an error for an unknown STORE_BACKEND")
+	}
+}
@@ -1,6 +1,7 @@
 package tests
 
 import (
+	"Todo-Tasker/config"
 	"Todo-Tasker/server"
 	"net/http"
 	"net/http/httptest"
@@ -10,7 +11,7 @@ import (
 
 // TestNew tests the main server route creation
 func TestNew(t *testing.T) {
-	mux := server.New()
+	mux := server.New(config.Config{})
 	if mux == nil {
 		t.Fatal("Expected non-nil ServeMux from New()")
 	}
@@ -20,8 +21,6 @@ func TestNew(t *testing.T) {
 		method         string
 		path           string
 		expectedStatus int
-		checkRedirect  bool
-		redirectTo     string
 	}{
 		{
 			name:           "index page",
@@ -39,21 +38,19 @@ func TestNew(t *testing.T) {
 			name:           "error page",
 			method:         http.MethodGet,
 			path:           "/error/",
-			expectedStatus: http.StatusOK,
+			expectedStatus: http.StatusInternalServerError,
 		},
 		{
-			name:           "non-existent route redirects to 404",
+			name:           "non-existent route returns 404",
 			method:         http.MethodGet,
 			path:           "/nonexistent",
-			expectedStatus: http.StatusFound,
-			checkRedirect:  true,
-			redirectTo:     "/404",
+			expectedStatus: http.StatusNotFound,
 		},
 		{
-			name:           "components POST endpoint",
+			name:           "components POST endpoint returns 404 for unregistered component",
 			method:         http.MethodPost,
-			path:           "/c/navbar",
-			expectedStatus: http.StatusFound, // Will redirect to 404 if component doesn't exist
+			path:           "/c/unknown",
+			expectedStatus: http.StatusNotFound,
 		},
 	}
 
@@ -67,20 +64,13 @@ func TestNew(t *testing.T) {
 			if w.Code != tt.expectedStatus {
 				t.Errorf("Expected status %d, got %d", tt.expectedStatus, w.Code)
 			}
-
-			if tt.checkRedirect {
-				location := w.Header().Get("Location")
-				if !strings.Contains(location, tt.redirectTo) {
-					t.Errorf("Expected redirect to contain %s, got %s", tt.redirectTo, location)
-				}
-			}
 		})
 	}
 }
 
 // TestNewAdmin tests the admin server route creation
 func TestNewAdmin(t *testing.T) {
-	mux := server.NewAdmin()
+	mux := server.NewAdmin(config.Config{})
 	if mux == nil {
 		t.Fatal("Expected non-nil ServeMux from NewAdmin()")
 	}
@@ -94,13 +84,13 @@ func TestNewAdmin(t *testing.T) {
 		{
 			name:           "admin page",
 			method:         http.MethodGet,
-			path:           "/admin/",
+			path:           "/",
 			expectedStatus: http.StatusOK,
 		},
 		{
 			name:           "non-admin route returns 404",
 			method:         http.MethodGet,
-			path:           "/",
+			path:           "/something",
 			expectedStatus: http.StatusNotFound,
 		},
 	}
@@ -121,13 +111,12 @@ func TestNewAdmin(t *testing.T) {
 
 // TestIndexPage tests the index page handler
 func TestIndexPage(t *testing.T) {
-	mux := server.New()
+	mux := server.New(config.Config{})
 
 	tests := []struct {
 		name           string
 		path           string
 		expectedStatus int
-		checkRedirect  bool
 	}{
 		{
 			name:           "root path returns OK",
@@ -135,10 +124,9 @@ func TestIndexPage(t *testing.T) {
 			expectedStatus: http.StatusOK,
 		},
 		{
-			name:           "non-root path redirects to 404",
+			name:           "non-root path returns 404",
 			path:           "/something",
-			expectedStatus: http.StatusFound,
-			checkRedirect:  true,
+			expectedStatus: http.StatusNotFound,
 		},
 	}
 
@@ -153,18 +141,9 @@ func TestIndexPage(t *testing.T) {
 				t.Errorf("Expected status %d, got %d", tt.expectedStatus, w.Code)
 			}
 
-			if tt.checkRedirect {
-				location := w.Header().Get("Location")
-				if location == "" {
-					t.Error("Expected redirect but got none")
-				}
-			}
-
-			if w.Code == http.StatusOK {
-				body := w.Body.String()
-				if body == "" {
-					t.Error("Expected non-empty response body")
-				}
+			body := w.Body.String()
+			if body == "" {
+				t.Error("Expected non-empty response body")
 			}
 		})
 	}
@@ -172,9 +151,9 @@ func TestIndexPage(t *testing.T) {
 
 // TestAdminPage tests the admin page handler
 func TestAdminPage(t *testing.T) {
-	mux := server.NewAdmin()
+	mux := server.NewAdmin(config.Config{})
 
-	req := httptest.NewRequest(http.MethodGet, "/admin/", nil)
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
 	w := httptest.NewRecorder()
 
 	mux.ServeHTTP(w, req)
@@ -191,7 +170,7 @@ func TestAdminPage(t *testing.T) {
 
 // TestPageNotFound tests the 404 error handler
 func TestPageNotFound(t *testing.T) {
-	mux := server.New()
+	mux := server.New(config.Config{})
 
 	req := httptest.NewRequest(http.MethodGet, "/404/", nil)
 	w := httptest.NewRecorder()
@@ -215,15 +194,15 @@ func TestPageNotFound(t *testing.T) {
 
 // TestErrorPage tests the generic error handler
 func TestErrorPage(t *testing.T) {
-	mux := server.New()
+	mux := server.New(config.Config{})
 
 	req := httptest.NewRequest(http.MethodGet, "/error/", nil)
 	w := httptest.NewRecorder()
 
 	mux.ServeHTTP(w, req)
 
-	if w.Code != http.StatusOK {
-		t.Errorf("Expected status %d, got %d", http.StatusOK, w.Code)
+	if w.Code != http.StatusInternalServerError {
+		t.Errorf("Expected status %d, got %d", http.StatusInternalServerError, w.Code)
 	}
 
 	body := w.Body.String()
@@ -239,25 +218,22 @@ func TestErrorPage(t *testing.T) {
 
 // TestComponentsPage tests the dynamic components endpoint
 func TestComponentsPage(t *testing.T) {
-	mux := server.New()
+	mux := server.New(config.Config{})
 
 	tests := []struct {
 		name           string
 		componentName  string
 		expectedStatus int
-		checkRedirect  bool
 	}{
 		{
-			name:           "component request",
+			name:           "registered component renders",
 			componentName:  "navbar",
-			expectedStatus: http.StatusFound, // Will redirect if component doesn't exist
-			checkRedirect:  true,
+			expectedStatus: http.StatusOK,
 		},
 		{
-			name:           "another component request",
+			name:           "unregistered component",
 			componentName:  "footer",
-			expectedStatus: http.StatusFound,
-			checkRedirect:  true,
+			expectedStatus: http.StatusNotFound,
 		},
 	}
 
@@ -268,10 +244,8 @@ func TestComponentsPage(t *testing.T) {
 
 			mux.ServeHTTP(w, req)
 
-			// Component endpoint will redirect to 404 if component doesn't exist
-			// or return OK if it does
-			if w.Code != http.StatusOK && w.Code != http.StatusFound {
-				t.Errorf("Expected status %d or %d, got %d", http.StatusOK, http.StatusFound, w.Code)
+			if w.Code != tt.expectedStatus {
+				t.Errorf("Expected status %d, got %d", tt.expectedStatus, w.Code)
 			}
 		})
 	}
@@ -279,7 +253,7 @@ func TestComponentsPage(t *testing.T) {
 
 // TestStaticAssets tests that the assets handler is properly configured
 func TestStaticAssets(t *testing.T) {
-	mux := server.New()
+	mux := server.New(config.Config{})
 
 	// Test that assets path is handled (even if specific asset doesn't exist)
 	req := httptest.NewRequest(http.MethodGet, "/assets/test.css", nil)
@@ -296,7 +270,7 @@ func TestStaticAssets(t *testing.T) {
 
 // TestHTTPMethods tests that routes respond correctly to different HTTP methods
 func TestHTTPMethods(t *testing.T) {
-	mux := server.New()
+	mux := server.New(config.Config{})
 
 	tests := []struct {
 		name           string
@@ -320,7 +294,7 @@ func TestHTTPMethods(t *testing.T) {
 			name:           "POST to components allowed",
 			method:         http.MethodPost,
 			path:           "/c/test",
-			expectedStatus: http.StatusFound, // or OK if component exists
+			expectedStatus: http.StatusNotFound, // or OK if component exists
 		},
 		{
 			name:           "GET to components not allowed",
@@ -346,7 +320,7 @@ func TestHTTPMethods(t *testing.T) {
 
 // TestContentType tests that responses have appropriate content types
 func TestContentType(t *testing.T) {
-	mux := server.New()
+	mux := server.New(config.Config{})
 
 	tests := []struct {
 		name                string